@@ -1,10 +1,14 @@
 package drain
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/weaveworks/eksctl/pkg/drain/evictor"
 
@@ -15,7 +19,11 @@ import (
 	"github.com/weaveworks/eksctl/pkg/eks"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 )
 
 // this is our custom addition, it's not part of the package
@@ -27,78 +35,239 @@ const retryDelay = 5 * time.Second
 //go:generate counterfeiter -o fakes/fake_drainer.go . Drainer
 type Drainer interface {
 	CanUseEvictions() error
-	EvictOrDeletePod(pod corev1.Pod) error
-	GetPodsForDeletion(nodeName string) (*evictor.PodDeleteList, []error)
+	EvictOrDeletePod(ctx context.Context, pod corev1.Pod) error
+	GetPodsForDeletion(ctx context.Context, nodeName string) (*evictor.PodDeleteList, []error)
 }
 
+// defaultParallelDrain is the number of nodes drained concurrently when the
+// caller does not request a specific worker pool size.
+const defaultParallelDrain = 1
+
+// defaultPodEvictionRetries is the number of times eviction of a single pod
+// is attempted before falling back to a force delete, when the caller does
+// not request a specific retry budget.
+const defaultPodEvictionRetries = 5
+
+// defaultPodTerminationTimeout is how long Drain waits for an evicted pod to
+// actually terminate before giving up and reporting it as stuck.
+const defaultPodTerminationTimeout = 2 * time.Minute
+
+// defaultPodTerminationPollInterval is how often a pod's status is polled
+// while waiting for it to terminate.
+const defaultPodTerminationPollInterval = 2 * time.Second
+
+// defaultIgnoredDaemonSets is the built-in list of well-known DaemonSets
+// that ship with eksctl-created clusters and should never be evicted.
+// Users can append further DaemonSets to this list via
+// DrainConfig.ExtraDaemonSetsToIgnore; pods that are not managed by a
+// DaemonSet are skipped through DrainConfig.PodFilters instead.
+func defaultIgnoredDaemonSets() []metav1.ObjectMeta {
+	return []metav1.ObjectMeta{
+		{
+			Namespace: "kube-system",
+			Name:      "aws-node",
+		},
+		{
+			Namespace: "kube-system",
+			Name:      "kube-proxy",
+		},
+		{
+			Name: "node-exporter",
+		},
+		{
+			Name: "prom-node-exporter",
+		},
+		{
+			Name: "weave-scope",
+		},
+		{
+			Name: "weave-scope-agent",
+		},
+		{
+			Name: "weave-net",
+		},
+	}
+}
+
+// PodFilter is a predicate run against every candidate pod before it is
+// evicted. It returns true if the pod should be skipped (left running)
+// rather than evicted, which is how users skip pods by label
+// (nodeGroup.drain.skipPodsWithLabels in the ClusterConfig, see
+// SkipPodsWithLabelsFilter).
+type PodFilter func(pod corev1.Pod) bool
+
+// DrainConfig controls how NodeGroupDrainer selects and treats pods during
+// a drain. It replaces the previous hard-coded IgnoreDaemonSets list and
+// always-on Force/DeleteLocalData/IgnoreAllDaemonSets flags. The caller is
+// expected to populate it from the nodegroup's ClusterConfig
+// (nodeGroup.drain.ignoreDaemonSets, nodeGroup.drain.skipPodsWithLabels)
+// before calling NewNodeGroupDrainer; pkg/drain has no visibility into
+// ClusterConfig types itself.
+type DrainConfig struct {
+	// PodFilters are applied, in order, to every candidate pod in addition
+	// to the built-in filters (e.g. DaemonSet, mirror pod, unreplicated
+	// pod). Use this to skip workloads such as Argo Workflows or Spark
+	// drivers that should not be evicted.
+	PodFilters []PodFilter
+	// ExtraDaemonSetsToIgnore is appended to defaultIgnoredDaemonSets.
+	ExtraDaemonSetsToIgnore []metav1.ObjectMeta
+	// Force allows deletion of pods not managed by a controller.
+	Force bool
+	// DeleteEmptyDirData allows deletion of pods using emptyDir volumes.
+	DeleteEmptyDirData bool
+	// IgnoreAllDaemonSets ignores every DaemonSet-managed pod, not just
+	// those in defaultIgnoredDaemonSets/ExtraDaemonSetsToIgnore.
+	IgnoreAllDaemonSets bool
+	// DisableEviction makes Drain cordon nodes and skip the eviction loop
+	// entirely (a.k.a. --cordon-only / --no-drain).
+	DisableEviction bool
+}
+
+// SkipPodsWithLabelsFilter returns a PodFilter that skips eviction for any
+// pod matching all of skipLabels. Callers that load
+// nodeGroup.drain.skipPodsWithLabels from the nodegroup's ClusterConfig
+// should pass the result into DrainConfig.PodFilters, e.g. to leave
+// well-known long-running batch pods (Argo Workflows, Spark drivers)
+// untouched.
+func SkipPodsWithLabelsFilter(skipLabels map[string]string) PodFilter {
+	selector := labels.SelectorFromSet(skipLabels)
+	return func(pod corev1.Pod) bool {
+		return selector.Matches(labels.Set(pod.Labels))
+	}
+}
+
+// Event reasons recorded against pods and nodes touched by a drain, so that
+// `kubectl describe` and anything watching the event stream (audit,
+// alerting, workload-owner notification) can see why a workload was
+// terminated.
+const (
+	EventReasonCordoned          = "Cordoned"
+	EventReasonUncordoned        = "Uncordoned"
+	EventReasonDrained           = "Drained"
+	EventReasonEvictionStarted   = "EvictionStarted"
+	EventReasonEvictionSucceeded = "EvictionSucceeded"
+	EventReasonEvictionFailed    = "EvictionFailed"
+)
+
 type NodeGroupDrainer struct {
-	clientSet   kubernetes.Interface
-	drainer     Drainer
-	ng          eks.KubeNodeGroup
-	waitTimeout time.Duration
-	undo        bool
+	clientSet       kubernetes.Interface
+	drainer         Drainer
+	ng              eks.KubeNodeGroup
+	waitTimeout     time.Duration
+	undo            bool
+	disableEviction bool
+	// parallel is the number of nodes drained concurrently. Defaults to 1
+	// (sequential, the historical behaviour) when left unset.
+	parallel int
+	// podEvictionRetries is how many times eviction of a single pod is
+	// attempted before falling back to a force delete (unless
+	// disableEvictionFallback is set).
+	podEvictionRetries int
+	// disableEvictionFallback, when true, makes a pod that exhausts its
+	// eviction retry budget return an error for its node, which Drain
+	// retries on its next pass until waitTimeout, instead of being
+	// force-deleted.
+	disableEvictionFallback bool
+	// eventRecorder publishes Events on the pods and nodes touched by the
+	// drain, so operators can see why a workload was terminated.
+	eventRecorder record.EventRecorder
+	// stopEventRecording shuts down the broadcaster backing eventRecorder.
+	// Drain calls it once the drain is finished so the sink goroutine it
+	// started doesn't leak.
+	stopEventRecording func()
+	// podTerminationTimeout bounds how long Drain waits for an evicted pod
+	// to actually disappear before it is reported as stuck.
+	podTerminationTimeout time.Duration
+	// podFilters are applied to every candidate pod in addition to the
+	// evictor's own built-in filters; a pod matching any of them is left
+	// running rather than evicted.
+	podFilters []PodFilter
 }
 
-func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, waitTimeout time.Duration, maxGracePeriod time.Duration, undo bool) NodeGroupDrainer {
+// NewNodeGroupDrainer creates a new NodeGroupDrainer.
+// cfg.DisableEviction, when true, makes Drain only cordon the nodegroup's
+// nodes and skip the eviction loop entirely, e.g. so an operator can mark a
+// nodegroup unschedulable ahead of a maintenance window without immediately
+// disrupting running pods.
+// parallel controls how many nodes are drained concurrently; a value <= 1
+// preserves the original sequential behaviour.
+// podEvictionRetries controls how many times eviction of a single pod is
+// attempted (e.g. because of a stuck PDB) before it is force-deleted with a
+// zero grace period, unless disableEvictionFallback is set, in which case
+// the node's eviction fails and Drain retries it until waitTimeout.
+// podTerminationTimeout bounds how long Drain waits for an evicted pod to
+// actually terminate before reporting it as stuck; a value <= 0 falls back
+// to defaultPodTerminationTimeout.
+func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, waitTimeout time.Duration, maxGracePeriod time.Duration, undo bool, parallel, podEvictionRetries int, disableEvictionFallback bool, podTerminationTimeout time.Duration, cfg DrainConfig) NodeGroupDrainer {
 	drainer := &evictor.Evictor{
 		Client: clientSet,
 
-		// TODO: Force, DeleteLocalData & IgnoreAllDaemonSets shouldn't
-		// be enabled by default, we need flags to control these, but that
-		// requires more improvements in the underlying drain package,
-		// as it currently produces errors and warnings with references
-		// to kubectl flags
-		Force:               true,
-		DeleteLocalData:     true,
-		IgnoreAllDaemonSets: true,
+		Force:               cfg.Force,
+		DeleteLocalData:     cfg.DeleteEmptyDirData,
+		IgnoreAllDaemonSets: cfg.IgnoreAllDaemonSets,
 
 		MaxGracePeriodSeconds: int(maxGracePeriod.Seconds()),
 
-		// TODO: ideally only the list of well-known DaemonSets should
-		// be set by default
-		IgnoreDaemonSets: []metav1.ObjectMeta{
-			{
-				Namespace: "kube-system",
-				Name:      "aws-node",
-			},
-			{
-				Namespace: "kube-system",
-				Name:      "kube-proxy",
-			},
-			{
-				Name: "node-exporter",
-			},
-			{
-				Name: "prom-node-exporter",
-			},
-			{
-				Name: "weave-scope",
-			},
-			{
-				Name: "weave-scope-agent",
-			},
-			{
-				Name: "weave-net",
-			},
-		},
+		IgnoreDaemonSets: append(defaultIgnoredDaemonSets(), cfg.ExtraDaemonSetsToIgnore...),
+	}
+
+	if parallel <= 0 {
+		parallel = defaultParallelDrain
+	}
+
+	if podEvictionRetries <= 0 {
+		podEvictionRetries = defaultPodEvictionRetries
 	}
 
+	if podTerminationTimeout <= 0 {
+		podTerminationTimeout = defaultPodTerminationTimeout
+	}
+
+	eventRecorder, stopEventRecording := newEventRecorder(clientSet)
+
 	return NodeGroupDrainer{
-		drainer:     drainer,
-		clientSet:   clientSet,
-		ng:          ng,
-		waitTimeout: waitTimeout,
-		undo:        undo,
+		drainer:                 drainer,
+		clientSet:               clientSet,
+		ng:                      ng,
+		waitTimeout:             waitTimeout,
+		undo:                    undo,
+		disableEviction:         cfg.DisableEviction,
+		parallel:                parallel,
+		podEvictionRetries:      podEvictionRetries,
+		disableEvictionFallback: disableEvictionFallback,
+		eventRecorder:           eventRecorder,
+		stopEventRecording:      stopEventRecording,
+		podTerminationTimeout:   podTerminationTimeout,
+		podFilters:              cfg.PodFilters,
 	}
 }
 
-// NodeGroup drains a nodegroup
-func (n *NodeGroupDrainer) Drain() error {
+// newEventRecorder sets up an EventRecorder that publishes drain-related
+// Events (cordon/uncordon/drained, eviction started/succeeded/failed)
+// through the usual Kubernetes event sink, so they show up in `kubectl
+// describe` and anything watching the cluster event stream. The returned
+// func must be called once the drain is done with the recorder; it shuts
+// the broadcaster down after handing already queued events to the sink
+// goroutine, but does not wait for them to be written to the cluster.
+func newEventRecorder(clientSet kubernetes.Interface) (record.EventRecorder, func()) {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientSet.CoreV1().Events("")})
+	recorder := broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "eksctl-drain"})
+	return recorder, broadcaster.Shutdown
+}
+
+// Drain drains a nodegroup. The drain runs until it completes or ctx is
+// done, whichever comes first; a parent operation (nodegroup delete,
+// upgrade, or SIGINT handling) can cancel ctx to abort the drain promptly
+// instead of waiting for the current node or the full waitTimeout.
+func (n *NodeGroupDrainer) Drain(ctx context.Context) error {
+	defer n.stopEventRecording()
+
 	if err := n.drainer.CanUseEvictions(); err != nil {
 		return errors.Wrap(err, "checking if cluster implements policy API")
 	}
 
-	nodes, err := n.clientSet.CoreV1().Nodes().List(n.ng.ListOptions())
+	nodes, err := n.clientSet.CoreV1().Nodes().List(ctx, n.ng.ListOptions())
 	if err != nil {
 		return err
 	}
@@ -109,32 +278,47 @@ func (n *NodeGroupDrainer) Drain() error {
 	}
 
 	if n.undo {
-		n.toggleCordon(false, nodes)
+		n.toggleCordon(ctx, false, nodes)
 		return nil // no need to kill any pods
 	}
 
+	n.toggleCordon(ctx, true, nodes)
+
+	if n.disableEviction {
+		logger.Info("eviction skipped for nodegroup %q (--cordon-only/--no-drain), nodes are cordoned but pods were not evicted", n.ng.NameString())
+		return nil
+	}
+
+	// ctx is bounded by waitTimeout in addition to whatever deadline the
+	// caller already attached to it, so a hung eviction cannot outlive the
+	// overall drain.
+	ctx, cancel := context.WithTimeout(ctx, n.waitTimeout)
+	defer cancel()
+
 	drainedNodes := sets.NewString()
 	// loop until all nodes are drained to handle accidental scale-up
 	// or any other changes in the ASG
-	timer := time.NewTimer(n.waitTimeout)
-	defer timer.Stop()
-
 	timeoutErr := fmt.Errorf("timed out (after %s) waiting for nodegroup %q to be drained", n.waitTimeout, n.ng.NameString())
 	for {
 		select {
-		case <-timer.C:
-			return timeoutErr
+		case <-ctx.Done():
+			if err := ctx.Err(); err == context.DeadlineExceeded {
+				return timeoutErr
+			}
+			return ctx.Err()
 		default:
-			nodes, err := n.clientSet.CoreV1().Nodes().List(n.ng.ListOptions())
+			nodes, err := n.clientSet.CoreV1().Nodes().List(ctx, n.ng.ListOptions())
 			if err != nil {
 				return err
 			}
 
 			newPendingNodes := sets.NewString()
+			nodeObjs := make(map[string]corev1.Node, len(nodes.Items))
 
-			n.toggleCordon(true, nodes)
+			n.toggleCordon(ctx, true, nodes)
 
 			for _, node := range nodes.Items {
+				nodeObjs[node.Name] = node
 				if drainedNodes.Has(node.Name) {
 					continue // already drained, get next one
 				}
@@ -149,28 +333,78 @@ func (n *NodeGroupDrainer) Drain() error {
 			logger.Debug("already drained: %v", drainedNodes.List())
 			logger.Debug("will drain: %v", newPendingNodes.List())
 
-			for _, node := range newPendingNodes.List() {
-				pending, err := n.evictPods(node)
-				if err != nil {
-					logger.Warning("pod eviction error (%q) on node %s – will retry after delay of %s", err, node, retryDelay)
-					time.Sleep(retryDelay)
+			for node := range n.drainNodes(ctx, newPendingNodes.List()) {
+				if node.err != nil {
+					logger.Warning("pod eviction error (%q) on node %s – will retry after delay of %s", node.err, node.name, retryDelay)
+					select {
+					case <-ctx.Done():
+					case <-time.After(retryDelay):
+					}
 					continue
 				}
-				logger.Debug("%d pods to be evicted from %s", pending, node)
-				if pending == 0 {
-					drainedNodes.Insert(node)
+				logger.Debug("%d pods to be evicted from %s", node.pending, node.name)
+				if node.pending == 0 {
+					drainedNodes.Insert(node.name)
+					if obj, ok := nodeObjs[node.name]; ok {
+						n.eventRecorder.Eventf(&obj, corev1.EventTypeNormal, EventReasonDrained, "Node drained by eksctl drain nodegroup")
+					}
 				}
-
 			}
 		}
 	}
 }
 
-func (n *NodeGroupDrainer) toggleCordon(cordon bool, nodes *corev1.NodeList) {
+// nodeDrainResult carries the outcome of draining a single node back from a
+// worker to the caller.
+type nodeDrainResult struct {
+	name    string
+	pending int
+	err     error
+}
+
+// drainNodes evicts pods from the given nodes using a bounded pool of
+// n.parallel workers, each owning one node at a time. Results are streamed
+// back over the returned channel as they complete, which is closed once all
+// nodes have been processed.
+func (n *NodeGroupDrainer) drainNodes(ctx context.Context, nodes []string) <-chan nodeDrainResult {
+	results := make(chan nodeDrainResult, len(nodes))
+
+	nodeCh := make(chan string, len(nodes))
+	for _, node := range nodes {
+		nodeCh <- node
+	}
+	close(nodeCh)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n.parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for node := range nodeCh {
+				if ctx.Err() != nil {
+					results <- nodeDrainResult{name: node, err: ctx.Err()}
+					continue
+				}
+				pending, err := n.evictPods(ctx, node)
+				results <- nodeDrainResult{name: node, pending: pending, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+func (n *NodeGroupDrainer) toggleCordon(ctx context.Context, cordon bool, nodes *corev1.NodeList) {
 	for _, node := range nodes.Items {
+		node := node
 		c := NewCordonHelper(&node, cordon)
 		if c.IsUpdateRequired() {
-			err, patchErr := c.PatchOrReplace(n.clientSet)
+			err, patchErr := c.PatchOrReplace(ctx, n.clientSet)
 			if patchErr != nil {
 				logger.Warning(patchErr.Error())
 			}
@@ -178,6 +412,7 @@ func (n *NodeGroupDrainer) toggleCordon(cordon bool, nodes *corev1.NodeList) {
 				logger.Critical(err.Error())
 			}
 			logger.Info("%s node %q", cordonStatus(cordon), node.Name)
+			n.eventRecorder.Eventf(&node, corev1.EventTypeNormal, cordonEventReason(cordon), "Node %s by eksctl drain nodegroup", cordonStatus(cordon))
 		} else {
 			logger.Debug("no need to %s node %q", cordonStatus(cordon), node.Name)
 		}
@@ -185,8 +420,8 @@ func (n *NodeGroupDrainer) toggleCordon(cordon bool, nodes *corev1.NodeList) {
 
 }
 
-func (n *NodeGroupDrainer) evictPods(node string) (int, error) {
-	list, errs := n.drainer.GetPodsForDeletion(node)
+func (n *NodeGroupDrainer) evictPods(ctx context.Context, node string) (int, error) {
+	list, errs := n.drainer.GetPodsForDeletion(ctx, node)
 	if len(errs) > 0 {
 		return 0, fmt.Errorf("errs: %v", errs) // TODO: improve formatting
 	}
@@ -196,17 +431,242 @@ func (n *NodeGroupDrainer) evictPods(node string) (int, error) {
 	pods := list.Pods()
 	pending := len(pods)
 	for _, pod := range pods {
+		if n.skipPod(pod) {
+			pending--
+			continue
+		}
 		// TODO: handle API rate limiter error
-		if err := n.drainer.EvictOrDeletePod(pod); err != nil {
+		if err := n.evictPod(ctx, pod); err != nil {
 			return pending, err
 		}
 	}
 	return pending, nil
 }
 
+// skipPod reports whether pod matches one of n.podFilters and should be left
+// running rather than evicted.
+func (n *NodeGroupDrainer) skipPod(pod corev1.Pod) bool {
+	for _, filter := range n.podFilters {
+		if filter(pod) {
+			logger.Debug("skipping pod %s/%s (matched a pod filter)", pod.Namespace, pod.Name)
+			return true
+		}
+	}
+	return false
+}
+
+// evictPod evicts a single pod, making up to n.podEvictionRetries attempts
+// (e.g. to ride out a stuck PDB or an unresponsive kubelet). If the retry
+// budget is exhausted, or the pod is accepted for eviction but then hangs
+// terminating (e.g. a stuck finalizer), the pod is force-deleted with a
+// zero grace period rather than blocking the drain on it indefinitely,
+// unless disableEvictionFallback is set, in which case evictPod returns
+// an error.
+func (n *NodeGroupDrainer) evictPod(ctx context.Context, pod corev1.Pod) error {
+	n.eventRecorder.Eventf(&pod, corev1.EventTypeNormal, EventReasonEvictionStarted, "Evicting pod as part of eksctl drain nodegroup")
+
+	var err error
+	evicted := false
+	for attempt := 1; attempt <= n.podEvictionRetries; attempt++ {
+		if err = n.drainer.EvictOrDeletePod(ctx, pod); err == nil {
+			evicted = true
+			break
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		logger.Debug("eviction attempt %d/%d for pod %s/%s failed: %v", attempt, n.podEvictionRetries, pod.Namespace, pod.Name, err)
+
+		if attempt < n.podEvictionRetries {
+			// give a stuck PDB or kubelet time to recover before retrying,
+			// so the retry budget is actually spent over time rather than
+			// being burned through in milliseconds.
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryDelay):
+			}
+		}
+	}
+
+	if !evicted {
+		n.eventRecorder.Eventf(&pod, corev1.EventTypeWarning, EventReasonEvictionFailed, "Failed to evict pod after %d attempts: %v", n.podEvictionRetries, err)
+
+		if n.disableEvictionFallback {
+			return errors.Wrapf(err, "giving up on evicting pod %s/%s after %d attempts", pod.Namespace, pod.Name, n.podEvictionRetries)
+		}
+
+		logger.Warning("pod %s/%s did not evict after %d attempts, forcing deletion with grace period 0", pod.Namespace, pod.Name, n.podEvictionRetries)
+		return n.forceDeletePod(ctx, pod)
+	}
+
+	n.eventRecorder.Eventf(&pod, corev1.EventTypeNormal, EventReasonEvictionSucceeded, "Evicted pod")
+
+	waitErr := n.waitForDelete(ctx, pod)
+	if waitErr == nil || ctx.Err() != nil {
+		return waitErr
+	}
+
+	// The pod was accepted for eviction but never actually terminated
+	// (e.g. a stuck finalizer or an unresponsive kubelet) - fall back to a
+	// force delete instead of reporting the drain as stuck on it forever.
+	if n.disableEvictionFallback {
+		return waitErr
+	}
+
+	logger.Warning("pod %s/%s did not terminate within %s of being evicted, forcing deletion with grace period 0", pod.Namespace, pod.Name, n.podTerminationTimeout)
+	return n.forceDeletePod(ctx, pod)
+}
+
+// forceDeletePod deletes pod with a zero grace period and waits for it to
+// actually disappear, for use once the normal eviction path has given up on
+// it.
+func (n *NodeGroupDrainer) forceDeletePod(ctx context.Context, pod corev1.Pod) error {
+	gracePeriod := int64(0)
+	deleteErr := n.clientSet.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{GracePeriodSeconds: &gracePeriod})
+	if deleteErr != nil && !apierrors.IsNotFound(deleteErr) {
+		return errors.Wrapf(deleteErr, "force-deleting pod %s/%s", pod.Namespace, pod.Name)
+	}
+	return n.waitForDelete(ctx, pod)
+}
+
+// waitForDelete polls pod until it is gone, or the pod of that name is
+// now assigned to a different node (e.g. it was recreated elsewhere), or
+// n.podTerminationTimeout elapses. On timeout it resolves and logs the
+// pod's controller owner and blocking PodDisruptionBudget, if any, so the
+// operator knows which workload is stuck.
+func (n *NodeGroupDrainer) waitForDelete(ctx context.Context, pod corev1.Pod) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, n.podTerminationTimeout)
+	defer cancel()
+
+	err := wait.PollImmediateUntil(defaultPodTerminationPollInterval, func() (bool, error) {
+		current, getErr := n.clientSet.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(getErr) {
+			return true, nil
+		}
+		if getErr != nil {
+			return false, getErr
+		}
+		if current.Spec.NodeName != pod.Spec.NodeName {
+			return true, nil
+		}
+		return false, nil
+	}, timeoutCtx.Done())
+
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	owner := n.describeOwner(ctx, pod)
+	pdbName := n.blockingPDB(ctx, pod)
+	if pdbName != "" {
+		logger.Warning("pod %s/%s (owner: %s) is still terminating after %s, it may be blocked by PodDisruptionBudget %q", pod.Namespace, pod.Name, owner, n.podTerminationTimeout, pdbName)
+	} else {
+		logger.Warning("pod %s/%s (owner: %s) is still terminating after %s", pod.Namespace, pod.Name, owner, n.podTerminationTimeout)
+	}
+	return fmt.Errorf("timed out waiting for pod %s/%s to terminate", pod.Namespace, pod.Name)
+}
+
+// describeOwner returns a human-readable "Kind/name" description of the
+// pod's controlling owner (Deployment, StatefulSet, DaemonSet, ...), or
+// "Pod/<name>" if the pod has no controller. A ReplicaSet owner is resolved
+// one level further to the Deployment that manages it, since that's the
+// object an operator actually recognises and might want to scale down.
+func (n *NodeGroupDrainer) describeOwner(ctx context.Context, pod corev1.Pod) string {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller {
+			if ref.Kind == "ReplicaSet" {
+				if deployment := n.describeReplicaSetOwner(ctx, pod.Namespace, ref.Name); deployment != "" {
+					return deployment
+				}
+				return fmt.Sprintf("ReplicaSet/%s", ref.Name)
+			}
+			return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+		}
+	}
+	return fmt.Sprintf("Pod/%s", pod.Name)
+}
+
+// describeReplicaSetOwner returns the "Kind/name" of the controller that
+// owns the named ReplicaSet (typically a Deployment), or "" if it can't be
+// resolved (the ReplicaSet has no controller, or it couldn't be fetched).
+func (n *NodeGroupDrainer) describeReplicaSetOwner(ctx context.Context, namespace, name string) string {
+	rs, err := n.clientSet.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		logger.Debug("couldn't resolve owner of ReplicaSet %s/%s: %v", namespace, name, err)
+		return ""
+	}
+	for _, ref := range rs.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller {
+			return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+		}
+	}
+	return ""
+}
+
+// blockingPDB returns the name of a PodDisruptionBudget in the pod's
+// namespace whose selector matches the pod, if any. Errors are logged and
+// swallowed since this is best-effort diagnostics for a pod that is already
+// stuck.
+//
+// PodDisruptionBudgets are read through the policy/v1 API, falling back to
+// the deprecated policy/v1beta1 API for clusters older than Kubernetes 1.21
+// that don't yet serve policy/v1.
+func (n *NodeGroupDrainer) blockingPDB(ctx context.Context, pod corev1.Pod) string {
+	pdbs, err := n.clientSet.PolicyV1().PodDisruptionBudgets(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return n.blockingPDBLegacy(ctx, pod)
+		}
+		logger.Debug("couldn't list PodDisruptionBudgets in namespace %s: %v", pod.Namespace, err)
+		return ""
+	}
+	for _, pdb := range pdbs.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			return pdb.Name
+		}
+	}
+	return ""
+}
+
+// blockingPDBLegacy is the policy/v1beta1 fallback for clusters that don't
+// serve the policy/v1 PodDisruptionBudget API (policy/v1 is only available
+// from Kubernetes 1.21 onwards; policy/v1beta1 was removed in 1.25).
+func (n *NodeGroupDrainer) blockingPDBLegacy(ctx context.Context, pod corev1.Pod) string {
+	pdbs, err := n.clientSet.PolicyV1beta1().PodDisruptionBudgets(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Debug("couldn't list legacy PodDisruptionBudgets in namespace %s: %v", pod.Namespace, err)
+		return ""
+	}
+	for _, pdb := range pdbs.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			return pdb.Name
+		}
+	}
+	return ""
+}
+
 func cordonStatus(desired bool) string {
 	if desired {
 		return "cordon"
 	}
 	return "uncordon"
 }
+
+func cordonEventReason(desired bool) string {
+	if desired {
+		return EventReasonCordoned
+	}
+	return EventReasonUncordoned
+}
@@ -0,0 +1,96 @@
+package drain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/weaveworks/eksctl/pkg/drain/evictor"
+)
+
+// gatedDrainer is a Drainer whose GetPodsForDeletion blocks for a short,
+// fixed duration and tracks how many calls were in flight at once, so tests
+// can observe drainNodes' concurrency without depending on the evictor
+// package's pod list internals.
+type gatedDrainer struct {
+	hold time.Duration
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	seen        []string
+}
+
+func (d *gatedDrainer) CanUseEvictions() error { return nil }
+
+func (d *gatedDrainer) EvictOrDeletePod(ctx context.Context, pod corev1.Pod) error { return nil }
+
+func (d *gatedDrainer) GetPodsForDeletion(ctx context.Context, nodeName string) (*evictor.PodDeleteList, []error) {
+	d.mu.Lock()
+	d.inFlight++
+	if d.inFlight > d.maxInFlight {
+		d.maxInFlight = d.inFlight
+	}
+	d.seen = append(d.seen, nodeName)
+	d.mu.Unlock()
+
+	time.Sleep(d.hold)
+
+	d.mu.Lock()
+	d.inFlight--
+	d.mu.Unlock()
+
+	return nil, []error{fmt.Errorf("fake: no pods for %s", nodeName)}
+}
+
+func TestDrainNodesRespectsParallelism(t *testing.T) {
+	const nodeCount = 6
+	nodes := make([]string, nodeCount)
+	for i := range nodes {
+		nodes[i] = fmt.Sprintf("node-%d", i)
+	}
+
+	for _, tc := range []struct {
+		name        string
+		parallel    int
+		wantMaxGT1  bool
+		maxInFlight int
+	}{
+		{name: "sequential", parallel: 1, wantMaxGT1: false, maxInFlight: 1},
+		{name: "parallel", parallel: 3, wantMaxGT1: true, maxInFlight: 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			drainer := &gatedDrainer{hold: 20 * time.Millisecond}
+			n := &NodeGroupDrainer{drainer: drainer, parallel: tc.parallel}
+
+			results := map[string]nodeDrainResult{}
+			for res := range n.drainNodes(context.Background(), nodes) {
+				results[res.name] = res
+			}
+
+			if len(results) != nodeCount {
+				t.Fatalf("got %d results, want %d", len(results), nodeCount)
+			}
+			for _, node := range nodes {
+				res, ok := results[node]
+				if !ok {
+					t.Fatalf("missing result for %s", node)
+				}
+				if res.err == nil {
+					t.Fatalf("expected fake error for %s, got nil", node)
+				}
+			}
+
+			if drainer.maxInFlight > tc.maxInFlight {
+				t.Fatalf("maxInFlight = %d, want <= %d (pool size %d)", drainer.maxInFlight, tc.maxInFlight, tc.parallel)
+			}
+			if tc.wantMaxGT1 && drainer.maxInFlight <= 1 {
+				t.Fatalf("maxInFlight = %d, want > 1 with parallel = %d", drainer.maxInFlight, tc.parallel)
+			}
+		})
+	}
+}